@@ -0,0 +1,143 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package asserter contains tools to assert that responses returned by a Rosetta server
+// implementation are valid. It is used by rosetta-cli and can be used by client
+// implementations to avoid integrating against a non-compliant server.
+package asserter
+
+import (
+	"sync"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+// Asserter contains all logic to correctly assert Rosetta Server responses as defined by the
+// Rosetta specification. Once constructed, an Asserter is safe for concurrent use, including a
+// lenient Asserter's Operation and Block, which may append to its warnings from multiple
+// goroutines (ex: parallel block validation).
+type Asserter struct {
+	network                 *types.NetworkIdentifier
+	genesisBlock            *types.BlockIdentifier
+	operationTypes          []string
+	operationStatusMap      map[string]*types.OperationStatus
+	errorTypeMap            map[int32]*types.Error
+	historicalBalanceLookup bool
+	timestampStartIndex     *int64
+	callMethods             []string
+	balanceExemptions       []*types.BalanceExemption
+	mempoolCoins            bool
+
+	// lenient is non-nil when this Asserter was constructed with
+	// NewClientWithResponsesLenient or NewClientWithFileLenient. Downstream assertion methods
+	// consult it to decide whether to downgrade a validation error to a warning.
+	lenient *lenientConfig
+
+	// warningsMu guards warnings, which Operation and Block may append to concurrently when a
+	// lenient Asserter is shared across goroutines (ex: parallel block validation).
+	warningsMu sync.Mutex
+	warnings   []string
+}
+
+// NewClientWithResponses constructs a new Asserter using a *types.NetworkIdentifier,
+// *types.NetworkStatusResponse, and *types.NetworkOptionsResponse, all of which are usually
+// returned by a Rosetta server.
+func NewClientWithResponses(
+	network *types.NetworkIdentifier,
+	networkStatus *types.NetworkStatusResponse,
+	networkOptions *types.NetworkOptionsResponse,
+) (*Asserter, error) {
+	if err := NetworkIdentifier(network); err != nil {
+		return nil, err
+	}
+
+	if err := NetworkStatusResponse(networkStatus); err != nil {
+		return nil, err
+	}
+
+	if err := NetworkOptionsResponse(networkOptions); err != nil {
+		return nil, err
+	}
+
+	allow := networkOptions.Allow
+
+	return &Asserter{
+		network:                 network,
+		genesisBlock:            networkStatus.GenesisBlockIdentifier,
+		operationTypes:          allow.OperationTypes,
+		operationStatusMap:      populateOperationStatusMap(allow.OperationStatuses),
+		errorTypeMap:            populateErrorTypeMap(allow.Errors),
+		historicalBalanceLookup: allow.HistoricalBalanceLookup,
+		timestampStartIndex:     allow.TimestampStartIndex,
+		callMethods:             allow.CallMethods,
+		balanceExemptions:       allow.BalanceExemptions,
+		mempoolCoins:            allow.MempoolCoins,
+	}, nil
+}
+
+// ClientConfiguration returns the NetworkIdentifier, genesis BlockIdentifier, and full
+// *types.Allow capability set currently enforced by an Asserter. It is useful for constructing a
+// *types.NetworkOptionsResponse from an existing Asserter, such as when mirroring a remote
+// server's configuration.
+func (a *Asserter) ClientConfiguration() (
+	*types.NetworkIdentifier,
+	*types.BlockIdentifier,
+	*types.Allow,
+	error,
+) {
+	if a == nil {
+		return nil, nil, nil, ErrAsserterNotInitialized
+	}
+
+	statuses := make([]*types.OperationStatus, 0, len(a.operationStatusMap))
+	for _, status := range a.operationStatusMap {
+		statuses = append(statuses, status)
+	}
+
+	errs := make([]*types.Error, 0, len(a.errorTypeMap))
+	for _, e := range a.errorTypeMap {
+		errs = append(errs, e)
+	}
+
+	allow := &types.Allow{
+		OperationStatuses:       statuses,
+		OperationTypes:          a.operationTypes,
+		Errors:                  errs,
+		HistoricalBalanceLookup: a.historicalBalanceLookup,
+		TimestampStartIndex:     a.timestampStartIndex,
+		CallMethods:             a.callMethods,
+		BalanceExemptions:       a.balanceExemptions,
+		MempoolCoins:            a.mempoolCoins,
+	}
+
+	return a.network, a.genesisBlock, allow, nil
+}
+
+func populateOperationStatusMap(statuses []*types.OperationStatus) map[string]*types.OperationStatus {
+	m := make(map[string]*types.OperationStatus, len(statuses))
+	for _, status := range statuses {
+		m[status.Status] = status
+	}
+
+	return m
+}
+
+func populateErrorTypeMap(errs []*types.Error) map[int32]*types.Error {
+	m := make(map[int32]*types.Error, len(errs))
+	for _, e := range errs {
+		m[e.Code] = e
+	}
+
+	return m
+}
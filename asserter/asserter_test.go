@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sync"
 	"testing"
 
 	"github.com/coinbase/rosetta-sdk-go/types"
@@ -223,13 +224,13 @@ func TestNew(t *testing.T) {
 			}
 
 			assert.NotNil(t, asserter)
-			network, genesis, opTypes, opStatuses, errors, err := asserter.ClientConfiguration()
+			network, genesis, allow, err := asserter.ClientConfiguration()
 			assert.NoError(t, err)
 			assert.Equal(t, test.network, network)
 			assert.Equal(t, test.networkStatus.GenesisBlockIdentifier, genesis)
-			assert.ElementsMatch(t, test.networkOptions.Allow.OperationTypes, opTypes)
-			assert.ElementsMatch(t, test.networkOptions.Allow.OperationStatuses, opStatuses)
-			assert.ElementsMatch(t, test.networkOptions.Allow.Errors, errors)
+			assert.ElementsMatch(t, test.networkOptions.Allow.OperationTypes, allow.OperationTypes)
+			assert.ElementsMatch(t, test.networkOptions.Allow.OperationStatuses, allow.OperationStatuses)
+			assert.ElementsMatch(t, test.networkOptions.Allow.Errors, allow.Errors)
 		})
 
 		t.Run(fmt.Sprintf("%s with file", name), func(t *testing.T) {
@@ -262,13 +263,191 @@ func TestNew(t *testing.T) {
 			}
 
 			assert.NotNil(t, asserter)
-			network, genesis, opTypes, opStatuses, errors, err := asserter.ClientConfiguration()
+			network, genesis, allow, err := asserter.ClientConfiguration()
 			assert.NoError(t, err)
 			assert.Equal(t, test.network, network)
 			assert.Equal(t, test.networkStatus.GenesisBlockIdentifier, genesis)
-			assert.ElementsMatch(t, test.networkOptions.Allow.OperationTypes, opTypes)
-			assert.ElementsMatch(t, test.networkOptions.Allow.OperationStatuses, opStatuses)
-			assert.ElementsMatch(t, test.networkOptions.Allow.Errors, errors)
+			assert.ElementsMatch(t, test.networkOptions.Allow.OperationTypes, allow.OperationTypes)
+			assert.ElementsMatch(t, test.networkOptions.Allow.OperationStatuses, allow.OperationStatuses)
+			assert.ElementsMatch(t, test.networkOptions.Allow.Errors, allow.Errors)
 		})
 	}
 }
+
+func TestNewLenient(t *testing.T) {
+	validNetwork := &types.NetworkIdentifier{
+		Blockchain: "hello",
+		Network:    "world",
+	}
+
+	validNetworkStatus := &types.NetworkStatusResponse{
+		GenesisBlockIdentifier: &types.BlockIdentifier{
+			Index: 0,
+			Hash:  "block 0",
+		},
+		CurrentBlockIdentifier: &types.BlockIdentifier{
+			Index: 100,
+			Hash:  "block 100",
+		},
+		CurrentBlockTimestamp: MinUnixEpoch + 1,
+	}
+
+	noGenesisNetworkStatus := &types.NetworkStatusResponse{
+		CurrentBlockIdentifier: &types.BlockIdentifier{
+			Index: 100,
+			Hash:  "block 100",
+		},
+		CurrentBlockTimestamp: MinUnixEpoch + 1,
+	}
+
+	noOperationStatusesOptions := &types.NetworkOptionsResponse{
+		Version: &types.Version{
+			RosettaVersion: "1.2.3",
+			NodeVersion:    "1.0",
+		},
+		Allow: &types.Allow{
+			OperationTypes: []string{"Transfer"},
+		},
+	}
+
+	duplicateTypesOptions := &types.NetworkOptionsResponse{
+		Version: &types.Version{
+			RosettaVersion: "1.2.3",
+			NodeVersion:    "1.0",
+		},
+		Allow: &types.Allow{
+			OperationStatuses: []*types.OperationStatus{
+				{Status: "Success", Successful: true},
+			},
+			OperationTypes: []string{"Transfer", "Transfer"},
+		},
+	}
+
+	t.Run("empty Allow.OperationStatuses is rejected without leniency", func(t *testing.T) {
+		asserter, err := NewClientWithResponsesLenient(
+			validNetwork,
+			validNetworkStatus,
+			noOperationStatusesOptions,
+		)
+		assert.Nil(t, asserter)
+		assert.Equal(t, errors.New("no Allow.OperationStatuses found"), err)
+	})
+
+	t.Run("empty Allow.OperationStatuses becomes a warning", func(t *testing.T) {
+		asserter, err := NewClientWithResponsesLenient(
+			validNetwork,
+			validNetworkStatus,
+			noOperationStatusesOptions,
+			WithLenientChecks(LenientCheckEmptyOperationStatuses),
+		)
+		assert.NoError(t, err)
+		assert.NotNil(t, asserter)
+		assert.NotEmpty(t, asserter.Warnings())
+	})
+
+	t.Run("duplicate Allow.OperationTypes becomes a warning", func(t *testing.T) {
+		asserter, err := NewClientWithResponsesLenient(
+			validNetwork,
+			validNetworkStatus,
+			duplicateTypesOptions,
+			WithLenientChecks(LenientCheckDuplicateOperationTypes),
+		)
+		assert.NoError(t, err)
+		assert.NotNil(t, asserter)
+		assert.NotEmpty(t, asserter.Warnings())
+	})
+
+	t.Run("nil GenesisBlockIdentifier becomes a warning", func(t *testing.T) {
+		var reported []string
+		asserter, err := NewClientWithResponsesLenient(
+			validNetwork,
+			noGenesisNetworkStatus,
+			duplicateTypesOptions,
+			WithLenientChecks(LenientCheckNilGenesisBlockIdentifier, LenientCheckDuplicateOperationTypes),
+			WithReporter(reporterFunc(func(warning string) {
+				reported = append(reported, warning)
+			})),
+		)
+		assert.NoError(t, err)
+		assert.NotNil(t, asserter)
+		assert.NotEmpty(t, asserter.Warnings())
+		assert.Equal(t, asserter.Warnings(), reported)
+
+		_, genesis, _, err := asserter.ClientConfiguration()
+		assert.NoError(t, err)
+		assert.Nil(t, genesis)
+	})
+
+	t.Run("unknown Operation.Type becomes a warning", func(t *testing.T) {
+		asserter, err := NewClientWithResponsesLenient(
+			validNetwork,
+			validNetworkStatus,
+			duplicateTypesOptions,
+			WithLenientChecks(LenientCheckDuplicateOperationTypes, LenientCheckUnknownOperationTypes),
+		)
+		assert.NoError(t, err)
+		assert.NotNil(t, asserter)
+
+		err = asserter.Operation(&types.Operation{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			Type:                "Reward",
+		})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, asserter.Warnings())
+	})
+
+	t.Run("NetworkOptionsResponse honors leniency without changing the Asserter's configuration", func(t *testing.T) {
+		asserter, err := NewClientWithResponsesLenient(
+			validNetwork,
+			validNetworkStatus,
+			duplicateTypesOptions,
+			WithLenientChecks(LenientCheckDuplicateOperationTypes),
+		)
+		assert.NoError(t, err)
+		assert.NotNil(t, asserter)
+
+		assert.NoError(t, asserter.NetworkOptionsResponse(duplicateTypesOptions))
+		assert.Error(t, asserter.NetworkOptionsResponse(noOperationStatusesOptions))
+
+		network, _, allow, err := asserter.ClientConfiguration()
+		assert.NoError(t, err)
+		assert.Equal(t, validNetwork, network)
+		assert.ElementsMatch(t, duplicateTypesOptions.Allow.OperationTypes, allow.OperationTypes)
+	})
+
+	t.Run("Operation is safe for concurrent use", func(t *testing.T) {
+		asserter, err := NewClientWithResponsesLenient(
+			validNetwork,
+			validNetworkStatus,
+			duplicateTypesOptions,
+			WithLenientChecks(LenientCheckDuplicateOperationTypes, LenientCheckUnknownOperationTypes),
+		)
+		assert.NoError(t, err)
+		assert.NotNil(t, asserter)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				_ = asserter.Operation(&types.Operation{
+					OperationIdentifier: &types.OperationIdentifier{Index: 0},
+					Type:                "Reward",
+				})
+			}()
+		}
+		wg.Wait()
+
+		// 1 warning from the duplicate Allow.OperationTypes at construction, plus 1 per
+		// concurrent Operation call for the unrecognized "Reward" type.
+		assert.Len(t, asserter.Warnings(), 51)
+	})
+}
+
+// reporterFunc adapts a func(string) into a Reporter.
+type reporterFunc func(warning string)
+
+func (f reporterFunc) ReportWarning(warning string) {
+	f(warning)
+}
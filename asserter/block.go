@@ -0,0 +1,119 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asserter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+var (
+	// ErrOperationIdentifierIsNil is returned when an Operation's
+	// OperationIdentifier is nil.
+	ErrOperationIdentifierIsNil = errors.New("Operation.OperationIdentifier is nil")
+
+	// ErrOperationTypeEmpty is returned when an Operation's Type is empty.
+	ErrOperationTypeEmpty = errors.New("Operation.Type is empty")
+
+	// ErrTransactionIdentifierIsNil is returned when a Transaction's
+	// TransactionIdentifier is nil.
+	ErrTransactionIdentifierIsNil = errors.New("Transaction.TransactionIdentifier is nil")
+
+	// ErrBlockTimestampInvalid is returned when a Block's Timestamp is before MinUnixEpoch and
+	// the block's Index is not covered by Allow.TimestampStartIndex.
+	ErrBlockTimestampInvalid = errors.New("timestamp is before MinUnixEpoch")
+)
+
+// Operation ensures a *types.Operation is valid. The OperationIdentifier and Type are always
+// required. If the Type is not in the Allow.OperationTypes advertised by the asserted network,
+// this is treated as an error unless the Asserter was constructed with leniency for unknown
+// operation types, in which case it is reported as a warning instead.
+func (a *Asserter) Operation(operation *types.Operation) error {
+	if a == nil {
+		return ErrAsserterNotInitialized
+	}
+
+	if operation == nil || operation.OperationIdentifier == nil {
+		return ErrOperationIdentifierIsNil
+	}
+
+	if operation.Type == "" {
+		return ErrOperationTypeEmpty
+	}
+
+	if !a.containsOperationType(operation.Type) {
+		err := fmt.Errorf("Operation.Type %s is not allowed", operation.Type)
+		if a.lenient != nil && a.lenient.skipUnknownOperationTypes {
+			a.warnf("%s", err.Error())
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (a *Asserter) containsOperationType(operationType string) bool {
+	for _, t := range a.operationTypes {
+		if t == operationType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Block ensures a *types.Block is valid, including every Operation contained in each of its
+// Transactions.
+func (a *Asserter) Block(block *types.Block) error {
+	if a == nil {
+		return ErrAsserterNotInitialized
+	}
+
+	if err := BlockIdentifier(block.BlockIdentifier); err != nil {
+		return err
+	}
+
+	if a.genesisBlock != nil && block.BlockIdentifier.Index != a.genesisBlock.Index {
+		if err := BlockIdentifier(block.ParentBlockIdentifier); err != nil {
+			return err
+		}
+	}
+
+	// Blocks with an Index below Allow.TimestampStartIndex are not guaranteed to carry a valid
+	// Timestamp, so they are exempt from the MinUnixEpoch check.
+	if a.timestampStartIndex == nil || block.BlockIdentifier.Index >= *a.timestampStartIndex {
+		if block.Timestamp < MinUnixEpoch {
+			return ErrBlockTimestampInvalid
+		}
+	}
+
+	for _, transaction := range block.Transactions {
+		if transaction.TransactionIdentifier == nil {
+			return ErrTransactionIdentifierIsNil
+		}
+
+		for i, op := range transaction.Operations {
+			if err := a.Operation(op); err != nil {
+				return fmt.Errorf("operation %d invalid: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
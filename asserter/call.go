@@ -0,0 +1,78 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asserter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+var (
+	// ErrCallRequestIsNil is returned when a *types.CallRequest is nil.
+	ErrCallRequestIsNil = errors.New("CallRequest is nil")
+
+	// ErrCallMethodEmpty is returned when CallRequest.Method is empty.
+	ErrCallMethodEmpty = errors.New("CallRequest.Method is empty")
+
+	// ErrCallResponseIsNil is returned when a *types.CallResponse is nil.
+	ErrCallResponseIsNil = errors.New("CallResponse is nil")
+)
+
+// CallRequest ensures a *types.CallRequest is valid and that its Method was advertised in
+// Allow.CallMethods.
+func (a *Asserter) CallRequest(request *types.CallRequest) error {
+	if a == nil {
+		return ErrAsserterNotInitialized
+	}
+
+	if request == nil {
+		return ErrCallRequestIsNil
+	}
+
+	if err := NetworkIdentifier(request.NetworkIdentifier); err != nil {
+		return err
+	}
+
+	if request.Method == "" {
+		return ErrCallMethodEmpty
+	}
+
+	for _, method := range a.callMethods {
+		if method == request.Method {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("CallRequest.Method %s is not allowed", request.Method)
+}
+
+// CallResponse ensures a *types.CallResponse is valid.
+func (a *Asserter) CallResponse(response *types.CallResponse) error {
+	if a == nil {
+		return ErrAsserterNotInitialized
+	}
+
+	if response == nil {
+		return ErrCallResponseIsNil
+	}
+
+	if response.Result == nil {
+		return fmt.Errorf("CallResponse.Result is nil")
+	}
+
+	return nil
+}
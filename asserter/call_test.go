@@ -0,0 +1,174 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asserter
+
+import (
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newCapabilityAsserter(t *testing.T, allow *types.Allow) *Asserter {
+	t.Helper()
+
+	asserter, err := NewClientWithResponses(
+		&types.NetworkIdentifier{Blockchain: "hello", Network: "world"},
+		&types.NetworkStatusResponse{
+			GenesisBlockIdentifier: &types.BlockIdentifier{Index: 0, Hash: "block 0"},
+			CurrentBlockIdentifier: &types.BlockIdentifier{Index: 100, Hash: "block 100"},
+			CurrentBlockTimestamp:  MinUnixEpoch + 1,
+		},
+		&types.NetworkOptionsResponse{
+			Version: &types.Version{RosettaVersion: "1.2.3", NodeVersion: "1.0"},
+			Allow:   allow,
+		},
+	)
+	assert.NoError(t, err)
+	assert.NotNil(t, asserter)
+
+	return asserter
+}
+
+func baseAllow() *types.Allow {
+	return &types.Allow{
+		OperationStatuses: []*types.OperationStatus{{Status: "Success", Successful: true}},
+		OperationTypes:    []string{"Transfer"},
+	}
+}
+
+func TestNetworkOptionsResponseCapabilities(t *testing.T) {
+	t.Run("duplicate CallMethods is rejected", func(t *testing.T) {
+		allow := baseAllow()
+		allow.CallMethods = []string{"eth_call", "eth_call"}
+
+		_, err := NewClientWithResponses(
+			&types.NetworkIdentifier{Blockchain: "hello", Network: "world"},
+			&types.NetworkStatusResponse{
+				GenesisBlockIdentifier: &types.BlockIdentifier{Index: 0, Hash: "block 0"},
+				CurrentBlockIdentifier: &types.BlockIdentifier{Index: 100, Hash: "block 100"},
+				CurrentBlockTimestamp:  MinUnixEpoch + 1,
+			},
+			&types.NetworkOptionsResponse{
+				Version: &types.Version{RosettaVersion: "1.2.3", NodeVersion: "1.0"},
+				Allow:   allow,
+			},
+		)
+		assert.EqualError(t, err, "Allow.CallMethods contains a duplicate eth_call")
+	})
+
+	t.Run("BalanceExemption with empty SubAccountAddress is rejected", func(t *testing.T) {
+		empty := ""
+		allow := baseAllow()
+		allow.BalanceExemptions = []*types.BalanceExemption{
+			{SubAccountAddress: &empty, ExemptionType: types.BalanceExemptionTypeDynamic},
+		}
+
+		_, err := NewClientWithResponses(
+			&types.NetworkIdentifier{Blockchain: "hello", Network: "world"},
+			&types.NetworkStatusResponse{
+				GenesisBlockIdentifier: &types.BlockIdentifier{Index: 0, Hash: "block 0"},
+				CurrentBlockIdentifier: &types.BlockIdentifier{Index: 100, Hash: "block 100"},
+				CurrentBlockTimestamp:  MinUnixEpoch + 1,
+			},
+			&types.NetworkOptionsResponse{
+				Version: &types.Version{RosettaVersion: "1.2.3", NodeVersion: "1.0"},
+				Allow:   allow,
+			},
+		)
+		assert.Error(t, err)
+	})
+
+	t.Run("ClientConfiguration exposes the full Allow capability set", func(t *testing.T) {
+		startIndex := int64(50)
+		allow := baseAllow()
+		allow.TimestampStartIndex = &startIndex
+		allow.CallMethods = []string{"eth_call"}
+		allow.MempoolCoins = true
+
+		asserter := newCapabilityAsserter(t, allow)
+
+		_, _, gotAllow, err := asserter.ClientConfiguration()
+		assert.NoError(t, err)
+		assert.Equal(t, allow.TimestampStartIndex, gotAllow.TimestampStartIndex)
+		assert.Equal(t, allow.CallMethods, gotAllow.CallMethods)
+		assert.True(t, gotAllow.MempoolCoins)
+	})
+}
+
+func TestCallRequest(t *testing.T) {
+	allow := baseAllow()
+	allow.CallMethods = []string{"eth_call"}
+	asserter := newCapabilityAsserter(t, allow)
+
+	network := &types.NetworkIdentifier{Blockchain: "hello", Network: "world"}
+
+	assert.NoError(t, asserter.CallRequest(&types.CallRequest{
+		NetworkIdentifier: network,
+		Method:            "eth_call",
+	}))
+
+	err := asserter.CallRequest(&types.CallRequest{
+		NetworkIdentifier: network,
+		Method:            "eth_getBalance",
+	})
+	assert.EqualError(t, err, "CallRequest.Method eth_getBalance is not allowed")
+
+	assert.Equal(t, ErrCallMethodEmpty, asserter.CallRequest(&types.CallRequest{
+		NetworkIdentifier: network,
+	}))
+}
+
+func TestBlockTimestampStartIndex(t *testing.T) {
+	startIndex := int64(10)
+	allow := baseAllow()
+	allow.TimestampStartIndex = &startIndex
+	asserter := newCapabilityAsserter(t, allow)
+
+	// A block before TimestampStartIndex is exempt from the MinUnixEpoch check.
+	assert.NoError(t, asserter.Block(&types.Block{
+		BlockIdentifier:       &types.BlockIdentifier{Index: 5, Hash: "block 5"},
+		ParentBlockIdentifier: &types.BlockIdentifier{Index: 4, Hash: "block 4"},
+		Timestamp:             0,
+	}))
+
+	// A block at or after TimestampStartIndex must still have a valid Timestamp.
+	err := asserter.Block(&types.Block{
+		BlockIdentifier:       &types.BlockIdentifier{Index: 10, Hash: "block 10"},
+		ParentBlockIdentifier: &types.BlockIdentifier{Index: 9, Hash: "block 9"},
+		Timestamp:             0,
+	})
+	assert.Equal(t, ErrBlockTimestampInvalid, err)
+}
+
+func TestBlockOperationIdentifierIsNil(t *testing.T) {
+	asserter := newCapabilityAsserter(t, baseAllow())
+
+	// An Operation with no OperationIdentifier must be rejected, not dereferenced.
+	err := asserter.Block(&types.Block{
+		BlockIdentifier: &types.BlockIdentifier{Index: 0, Hash: "block 0"},
+		Timestamp:       MinUnixEpoch + 1,
+		Transactions: []*types.Transaction{
+			{
+				TransactionIdentifier: &types.TransactionIdentifier{Hash: "tx 0"},
+				Operations: []*types.Operation{
+					{Type: "Transfer"},
+				},
+			},
+		},
+	})
+	assert.EqualError(t, err, "operation 0 invalid: Operation.OperationIdentifier is nil")
+}
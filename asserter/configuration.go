@@ -0,0 +1,92 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asserter
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+// FileConfiguration is the structure of the file used to configure the asserter's validation
+// rules when a live Rosetta server is not available to query (i.e. in an offline context).
+type FileConfiguration struct {
+	// SchemaVersion identifies the layout of this FileConfiguration so that older on-disk
+	// configs can be migrated forward by migrateFileConfiguration. A file written before
+	// SchemaVersion existed unmarshals this to 0.
+	SchemaVersion              int                       `json:"schema_version,omitempty"`
+	NetworkIdentifier          *types.NetworkIdentifier  `json:"network_identifier"`
+	GenesisBlockIdentifier     *types.BlockIdentifier    `json:"genesis_block_identifier"`
+	AllowedOperationTypes      []string                  `json:"allowed_operation_types"`
+	AllowedOperationStatuses   []*types.OperationStatus  `json:"allowed_operation_statuses"`
+	AllowedErrors              []*types.Error            `json:"allowed_errors"`
+	AllowedTimestampStartIndex *int64                    `json:"allowed_timestamp_start_index,omitempty"`
+	AllowedCallMethods         []string                  `json:"allowed_call_methods,omitempty"`
+	AllowedBalanceExemptions   []*types.BalanceExemption `json:"allowed_balance_exemptions,omitempty"`
+	AllowedMempoolCoins        bool                      `json:"allowed_mempool_coins,omitempty"`
+}
+
+// NewClientWithFile constructs a new Asserter using a file at a provided path that contains a
+// JSON-encoded FileConfiguration.
+func NewClientWithFile(filePath string) (*Asserter, error) {
+	file, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var config FileConfiguration
+	if err := json.Unmarshal(file, &config); err != nil {
+		return nil, err
+	}
+
+	migrateFileConfiguration(&config)
+
+	if err := NetworkIdentifier(config.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	if err := BlockIdentifier(config.GenesisBlockIdentifier); err != nil {
+		return nil, err
+	}
+
+	if err := OperationStatuses(config.AllowedOperationStatuses); err != nil {
+		return nil, err
+	}
+
+	if err := OperationTypes(config.AllowedOperationTypes); err != nil {
+		return nil, err
+	}
+
+	if err := CallMethods(config.AllowedCallMethods); err != nil {
+		return nil, err
+	}
+
+	if err := BalanceExemptions(config.AllowedBalanceExemptions); err != nil {
+		return nil, err
+	}
+
+	return &Asserter{
+		network:             config.NetworkIdentifier,
+		genesisBlock:        config.GenesisBlockIdentifier,
+		operationTypes:      config.AllowedOperationTypes,
+		operationStatusMap:  populateOperationStatusMap(config.AllowedOperationStatuses),
+		errorTypeMap:        populateErrorTypeMap(config.AllowedErrors),
+		timestampStartIndex: config.AllowedTimestampStartIndex,
+		callMethods:         config.AllowedCallMethods,
+		balanceExemptions:   config.AllowedBalanceExemptions,
+		mempoolCoins:        config.AllowedMempoolCoins,
+	}, nil
+}
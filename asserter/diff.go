@@ -0,0 +1,154 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asserter
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+// ConfigurationDiff reports every addition or removal between two FileConfigurations, as
+// produced by DiffConfigurations.
+type ConfigurationDiff struct {
+	AddedOperationTypes   []string `json:"added_operation_types,omitempty"`
+	RemovedOperationTypes []string `json:"removed_operation_types,omitempty"`
+
+	AddedOperationStatuses   []*types.OperationStatus `json:"added_operation_statuses,omitempty"`
+	RemovedOperationStatuses []*types.OperationStatus `json:"removed_operation_statuses,omitempty"`
+
+	AddedErrors   []*types.Error `json:"added_errors,omitempty"`
+	RemovedErrors []*types.Error `json:"removed_errors,omitempty"`
+
+	// AllowFieldChanges describes every other Allow field (TimestampStartIndex, CallMethods,
+	// BalanceExemptions, MempoolCoins) that differs between old and new: one entry per field,
+	// except CallMethods, which gets one entry for added and one for removed methods.
+	AllowFieldChanges []string `json:"allow_field_changes,omitempty"`
+}
+
+// IsEmpty returns true if old and new were identical.
+func (d *ConfigurationDiff) IsEmpty() bool {
+	return len(d.AddedOperationTypes) == 0 &&
+		len(d.RemovedOperationTypes) == 0 &&
+		len(d.AddedOperationStatuses) == 0 &&
+		len(d.RemovedOperationStatuses) == 0 &&
+		len(d.AddedErrors) == 0 &&
+		len(d.RemovedErrors) == 0 &&
+		len(d.AllowFieldChanges) == 0
+}
+
+// DiffConfigurations compares old against updated and reports every operation type, operation
+// status, error, and other Allow-field change between them. It is intended to let a user detect
+// a breaking network upgrade (ex: a removed OperationStatus) before running rosetta-cli against
+// the new network.
+func DiffConfigurations(old, updated *FileConfiguration) *ConfigurationDiff {
+	diff := &ConfigurationDiff{
+		AddedOperationTypes:      diffStringSlice(old.AllowedOperationTypes, updated.AllowedOperationTypes),
+		RemovedOperationTypes:    diffStringSlice(updated.AllowedOperationTypes, old.AllowedOperationTypes),
+		AddedOperationStatuses:   diffOperationStatuses(old.AllowedOperationStatuses, updated.AllowedOperationStatuses),
+		RemovedOperationStatuses: diffOperationStatuses(updated.AllowedOperationStatuses, old.AllowedOperationStatuses),
+		AddedErrors:              diffErrors(old.AllowedErrors, updated.AllowedErrors),
+		RemovedErrors:            diffErrors(updated.AllowedErrors, old.AllowedErrors),
+	}
+
+	if !reflect.DeepEqual(old.AllowedTimestampStartIndex, updated.AllowedTimestampStartIndex) {
+		diff.AllowFieldChanges = append(diff.AllowFieldChanges, fmt.Sprintf(
+			"TimestampStartIndex: %s -> %s",
+			formatInt64Pointer(old.AllowedTimestampStartIndex),
+			formatInt64Pointer(updated.AllowedTimestampStartIndex),
+		))
+	}
+
+	if addedMethods := diffStringSlice(old.AllowedCallMethods, updated.AllowedCallMethods); len(addedMethods) > 0 {
+		diff.AllowFieldChanges = append(diff.AllowFieldChanges, fmt.Sprintf("CallMethods added: %v", addedMethods))
+	}
+
+	if removedMethods := diffStringSlice(updated.AllowedCallMethods, old.AllowedCallMethods); len(removedMethods) > 0 {
+		diff.AllowFieldChanges = append(diff.AllowFieldChanges, fmt.Sprintf("CallMethods removed: %v", removedMethods))
+	}
+
+	if !reflect.DeepEqual(old.AllowedBalanceExemptions, updated.AllowedBalanceExemptions) {
+		diff.AllowFieldChanges = append(diff.AllowFieldChanges, "BalanceExemptions changed")
+	}
+
+	if old.AllowedMempoolCoins != updated.AllowedMempoolCoins {
+		diff.AllowFieldChanges = append(diff.AllowFieldChanges, fmt.Sprintf(
+			"MempoolCoins: %t -> %t", old.AllowedMempoolCoins, updated.AllowedMempoolCoins,
+		))
+	}
+
+	return diff
+}
+
+func formatInt64Pointer(value *int64) string {
+	if value == nil {
+		return "nil"
+	}
+
+	return fmt.Sprintf("%d", *value)
+}
+
+// diffStringSlice returns every entry in b that is not present in a.
+func diffStringSlice(a, b []string) []string {
+	seen := make(map[string]struct{}, len(a))
+	for _, v := range a {
+		seen[v] = struct{}{}
+	}
+
+	var added []string
+	for _, v := range b {
+		if _, ok := seen[v]; !ok {
+			added = append(added, v)
+		}
+	}
+
+	return added
+}
+
+// diffOperationStatuses returns every *types.OperationStatus in b whose Status is not present
+// in a.
+func diffOperationStatuses(a, b []*types.OperationStatus) []*types.OperationStatus {
+	seen := make(map[string]struct{}, len(a))
+	for _, status := range a {
+		seen[status.Status] = struct{}{}
+	}
+
+	var added []*types.OperationStatus
+	for _, status := range b {
+		if _, ok := seen[status.Status]; !ok {
+			added = append(added, status)
+		}
+	}
+
+	return added
+}
+
+// diffErrors returns every *types.Error in b whose Code is not present in a.
+func diffErrors(a, b []*types.Error) []*types.Error {
+	seen := make(map[int32]struct{}, len(a))
+	for _, e := range a {
+		seen[e.Code] = struct{}{}
+	}
+
+	var added []*types.Error
+	for _, e := range b {
+		if _, ok := seen[e.Code]; !ok {
+			added = append(added, e)
+		}
+	}
+
+	return added
+}
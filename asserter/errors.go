@@ -0,0 +1,74 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asserter
+
+import "errors"
+
+// General errors returned while constructing an Asserter or asserting basic
+// types shared across the Data API and the Construction API.
+var (
+	// ErrAsserterNotInitialized is returned when a method is called on an
+	// Asserter that was never successfully constructed.
+	ErrAsserterNotInitialized = errors.New("asserter not initialized")
+
+	// ErrNetworkIdentifierIsNil is returned when a *types.NetworkIdentifier
+	// is nil.
+	ErrNetworkIdentifierIsNil = errors.New("NetworkIdentifier is nil")
+
+	// ErrBlockchainEmpty is returned when NetworkIdentifier.Blockchain is
+	// empty.
+	ErrBlockchainEmpty = errors.New("NetworkIdentifier.Blockchain is missing")
+
+	// ErrNetworkEmpty is returned when NetworkIdentifier.Network is empty.
+	ErrNetworkEmpty = errors.New("NetworkIdentifier.Network is missing")
+
+	// ErrBlockIdentifierIsNil is returned when a *types.BlockIdentifier is
+	// nil.
+	ErrBlockIdentifierIsNil = errors.New("BlockIdentifier is nil")
+
+	// ErrBlockIdentifierHashMissing is returned when BlockIdentifier.Hash is
+	// empty.
+	ErrBlockIdentifierHashMissing = errors.New("BlockIdentifier.Hash is missing")
+
+	// ErrBlockIdentifierIndexIsNeg is returned when BlockIdentifier.Index is
+	// negative.
+	ErrBlockIdentifierIndexIsNeg = errors.New("BlockIdentifier.Index is negative")
+
+	// ErrNetworkStatusResponseIsNil is returned when a
+	// *types.NetworkStatusResponse is nil.
+	ErrNetworkStatusResponseIsNil = errors.New("NetworkStatusResponse is nil")
+
+	// ErrCurrentBlockTimestampInvalid is returned when
+	// NetworkStatusResponse.CurrentBlockTimestamp is before MinUnixEpoch.
+	ErrCurrentBlockTimestampInvalid = errors.New("timestamp is before MinUnixEpoch")
+
+	// ErrNoAllowedOperationStatuses is returned when Allow.OperationStatuses
+	// is empty.
+	ErrNoAllowedOperationStatuses = errors.New("no Allow.OperationStatuses found")
+
+	// ErrNoAllowedOperationTypes is returned when Allow.OperationTypes is
+	// empty.
+	ErrNoAllowedOperationTypes = errors.New("no Allow.OperationTypes found")
+
+	// ErrNetworkOptionsResponseIsNil is returned when a
+	// *types.NetworkOptionsResponse is nil.
+	ErrNetworkOptionsResponseIsNil = errors.New("NetworkOptionsResponse is nil")
+
+	// ErrVersionIsNil is returned when NetworkOptionsResponse.Version is nil.
+	ErrVersionIsNil = errors.New("Version is nil")
+
+	// ErrAllowIsNil is returned when NetworkOptionsResponse.Allow is nil.
+	ErrAllowIsNil = errors.New("Allow is nil")
+)
@@ -0,0 +1,432 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asserter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+// LenientCheck identifies a single validation rule that a lenient Asserter is allowed to
+// downgrade from an error to a warning.
+type LenientCheck string
+
+const (
+	// LenientCheckEmptyOperationStatuses allows a NetworkOptionsResponse with no
+	// Allow.OperationStatuses.
+	LenientCheckEmptyOperationStatuses LenientCheck = "empty_operation_statuses"
+
+	// LenientCheckDuplicateOperationStatuses allows Allow.OperationStatuses to contain
+	// duplicate Status values.
+	LenientCheckDuplicateOperationStatuses LenientCheck = "duplicate_operation_statuses"
+
+	// LenientCheckDuplicateOperationTypes allows Allow.OperationTypes to contain duplicate
+	// entries.
+	LenientCheckDuplicateOperationTypes LenientCheck = "duplicate_operation_types"
+
+	// LenientCheckNilGenesisBlockIdentifier allows a NetworkStatusResponse with no
+	// GenesisBlockIdentifier.
+	LenientCheckNilGenesisBlockIdentifier LenientCheck = "nil_genesis_block_identifier"
+
+	// LenientCheckUnknownOperationTypes allows Operation.Type values encountered while
+	// asserting live data (ex: in Asserter.Operation) that were not advertised in
+	// Allow.OperationTypes.
+	LenientCheckUnknownOperationTypes LenientCheck = "unknown_operation_types"
+)
+
+// allLenientChecks is used by WithAllLenientChecks to enable every known LenientCheck.
+var allLenientChecks = []LenientCheck{
+	LenientCheckEmptyOperationStatuses,
+	LenientCheckDuplicateOperationStatuses,
+	LenientCheckDuplicateOperationTypes,
+	LenientCheckNilGenesisBlockIdentifier,
+	LenientCheckUnknownOperationTypes,
+}
+
+// Reporter is implemented by callers that want to observe the warnings a lenient Asserter
+// produces as it downgrades validation errors. It is invoked synchronously as each warning is
+// generated, both during construction and during later calls to methods like Operation and
+// Block.
+type Reporter interface {
+	ReportWarning(warning string)
+}
+
+// lenientConfig holds the set of checks a lenient Asserter has been told to relax and the
+// Reporter, if any, that should observe the resulting warnings.
+type lenientConfig struct {
+	reporter Reporter
+
+	skipEmptyOperationStatuses     bool
+	skipDuplicateOperationStatuses bool
+	skipDuplicateOperationTypes    bool
+	skipNilGenesisBlockIdentifier  bool
+	skipUnknownOperationTypes      bool
+}
+
+// LenientOption configures the leniency of an Asserter constructed with
+// NewClientWithResponsesLenient or NewClientWithFileLenient.
+type LenientOption func(*lenientConfig)
+
+// WithReporter registers a Reporter that is notified of every warning a lenient Asserter
+// produces.
+func WithReporter(reporter Reporter) LenientOption {
+	return func(c *lenientConfig) {
+		c.reporter = reporter
+	}
+}
+
+// WithLenientChecks downgrades the provided LenientChecks from errors to warnings. Checks not
+// passed here are still enforced as errors.
+func WithLenientChecks(checks ...LenientCheck) LenientOption {
+	return func(c *lenientConfig) {
+		for _, check := range checks {
+			switch check {
+			case LenientCheckEmptyOperationStatuses:
+				c.skipEmptyOperationStatuses = true
+			case LenientCheckDuplicateOperationStatuses:
+				c.skipDuplicateOperationStatuses = true
+			case LenientCheckDuplicateOperationTypes:
+				c.skipDuplicateOperationTypes = true
+			case LenientCheckNilGenesisBlockIdentifier:
+				c.skipNilGenesisBlockIdentifier = true
+			case LenientCheckUnknownOperationTypes:
+				c.skipUnknownOperationTypes = true
+			}
+		}
+	}
+}
+
+// WithAllLenientChecks downgrades every known LenientCheck from an error to a warning.
+func WithAllLenientChecks() LenientOption {
+	return WithLenientChecks(allLenientChecks...)
+}
+
+func newLenientConfig(opts ...LenientOption) *lenientConfig {
+	config := &lenientConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	return config
+}
+
+// Warnings returns every validation issue a lenient Asserter has downgraded from an error to a
+// warning so far, in the order they were encountered. It is empty for an Asserter constructed
+// with NewClientWithResponses or NewClientWithFile. It is safe to call concurrently with
+// Operation and Block on the same Asserter.
+func (a *Asserter) Warnings() []string {
+	if a == nil {
+		return nil
+	}
+
+	a.warningsMu.Lock()
+	defer a.warningsMu.Unlock()
+
+	warnings := make([]string, len(a.warnings))
+	copy(warnings, a.warnings)
+
+	return warnings
+}
+
+// warnf is called during construction and from Operation/Block, both of which may run
+// concurrently on a lenient Asserter shared across goroutines (ex: parallel block validation),
+// so it guards access to a.warnings with a.warningsMu.
+func (a *Asserter) warnf(format string, args ...interface{}) {
+	warning := fmt.Sprintf(format, args...)
+
+	a.warningsMu.Lock()
+	a.warnings = append(a.warnings, warning)
+	a.warningsMu.Unlock()
+
+	if a.lenient != nil && a.lenient.reporter != nil {
+		a.lenient.reporter.ReportWarning(warning)
+	}
+}
+
+// NewClientWithResponsesLenient behaves like NewClientWithResponses but downgrades the
+// validation errors selected by opts to warnings instead of failing construction. This is
+// useful for integrating against Rosetta servers that do not fully conform to the spec (ex:
+// missing Allow.OperationStatuses or duplicate operation types).
+func NewClientWithResponsesLenient(
+	network *types.NetworkIdentifier,
+	networkStatus *types.NetworkStatusResponse,
+	networkOptions *types.NetworkOptionsResponse,
+	opts ...LenientOption,
+) (*Asserter, error) {
+	lenient := newLenientConfig(opts...)
+
+	if err := NetworkIdentifier(network); err != nil {
+		return nil, err
+	}
+
+	if networkOptions == nil {
+		return nil, ErrNetworkOptionsResponseIsNil
+	}
+
+	if networkOptions.Version == nil {
+		return nil, ErrVersionIsNil
+	}
+
+	if networkOptions.Allow == nil {
+		return nil, ErrAllowIsNil
+	}
+
+	a := &Asserter{lenient: lenient}
+
+	genesisBlock, err := a.assertNetworkStatusResponseLenient(networkStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	operationStatuses, err := a.assertOperationStatusesLenient(networkOptions.Allow.OperationStatuses)
+	if err != nil {
+		return nil, err
+	}
+
+	operationTypes, err := a.assertOperationTypesLenient(networkOptions.Allow.OperationTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CallMethods(networkOptions.Allow.CallMethods); err != nil {
+		return nil, err
+	}
+
+	if err := BalanceExemptions(networkOptions.Allow.BalanceExemptions); err != nil {
+		return nil, err
+	}
+
+	a.network = network
+	a.genesisBlock = genesisBlock
+	a.operationTypes = operationTypes
+	a.operationStatusMap = populateOperationStatusMap(operationStatuses)
+	a.errorTypeMap = populateErrorTypeMap(networkOptions.Allow.Errors)
+	a.historicalBalanceLookup = networkOptions.Allow.HistoricalBalanceLookup
+	a.timestampStartIndex = networkOptions.Allow.TimestampStartIndex
+	a.callMethods = networkOptions.Allow.CallMethods
+	a.balanceExemptions = networkOptions.Allow.BalanceExemptions
+	a.mempoolCoins = networkOptions.Allow.MempoolCoins
+
+	return a, nil
+}
+
+// NewClientWithFileLenient behaves like NewClientWithFile but downgrades the validation errors
+// selected by opts to warnings instead of failing construction.
+func NewClientWithFileLenient(filePath string, opts ...LenientOption) (*Asserter, error) {
+	file, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var config FileConfiguration
+	if err := json.Unmarshal(file, &config); err != nil {
+		return nil, err
+	}
+
+	migrateFileConfiguration(&config)
+
+	if err := NetworkIdentifier(config.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	lenient := newLenientConfig(opts...)
+	a := &Asserter{lenient: lenient}
+
+	genesisBlock, err := a.assertBlockIdentifierLenient(
+		config.GenesisBlockIdentifier,
+		lenient.skipNilGenesisBlockIdentifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	operationStatuses, err := a.assertOperationStatusesLenient(config.AllowedOperationStatuses)
+	if err != nil {
+		return nil, err
+	}
+
+	operationTypes, err := a.assertOperationTypesLenient(config.AllowedOperationTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CallMethods(config.AllowedCallMethods); err != nil {
+		return nil, err
+	}
+
+	if err := BalanceExemptions(config.AllowedBalanceExemptions); err != nil {
+		return nil, err
+	}
+
+	a.network = config.NetworkIdentifier
+	a.genesisBlock = genesisBlock
+	a.operationTypes = operationTypes
+	a.operationStatusMap = populateOperationStatusMap(operationStatuses)
+	a.errorTypeMap = populateErrorTypeMap(config.AllowedErrors)
+	a.timestampStartIndex = config.AllowedTimestampStartIndex
+	a.callMethods = config.AllowedCallMethods
+	a.balanceExemptions = config.AllowedBalanceExemptions
+	a.mempoolCoins = config.AllowedMempoolCoins
+
+	return a, nil
+}
+
+// assertNetworkStatusResponseLenient validates a *types.NetworkStatusResponse, downgrading a
+// nil GenesisBlockIdentifier to a warning if LenientCheckNilGenesisBlockIdentifier was
+// requested. It returns the GenesisBlockIdentifier to use on the constructed Asserter (which may
+// be nil).
+func (a *Asserter) assertNetworkStatusResponseLenient(
+	response *types.NetworkStatusResponse,
+) (*types.BlockIdentifier, error) {
+	if response == nil {
+		return nil, ErrNetworkStatusResponseIsNil
+	}
+
+	if err := BlockIdentifier(response.CurrentBlockIdentifier); err != nil {
+		return nil, err
+	}
+
+	if response.CurrentBlockTimestamp < MinUnixEpoch {
+		return nil, ErrCurrentBlockTimestampInvalid
+	}
+
+	return a.assertBlockIdentifierLenient(
+		response.GenesisBlockIdentifier,
+		a.lenient.skipNilGenesisBlockIdentifier,
+	)
+}
+
+// assertBlockIdentifierLenient validates a *types.BlockIdentifier, downgrading a nil identifier
+// to a warning when skipNil is set.
+func (a *Asserter) assertBlockIdentifierLenient(
+	block *types.BlockIdentifier,
+	skipNil bool,
+) (*types.BlockIdentifier, error) {
+	if block == nil {
+		if skipNil {
+			a.warnf("%s", ErrBlockIdentifierIsNil.Error())
+			return nil, nil
+		}
+
+		return nil, ErrBlockIdentifierIsNil
+	}
+
+	if err := BlockIdentifier(block); err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
+
+// assertOperationStatusesLenient validates Allow.OperationStatuses, downgrading an empty list
+// and/or duplicate statuses to warnings as configured. It returns the OperationStatuses to use
+// on the constructed Asserter.
+func (a *Asserter) assertOperationStatusesLenient(
+	statuses []*types.OperationStatus,
+) ([]*types.OperationStatus, error) {
+	if len(statuses) == 0 {
+		if a.lenient != nil && a.lenient.skipEmptyOperationStatuses {
+			a.warnf("%s", ErrNoAllowedOperationStatuses.Error())
+			return statuses, nil
+		}
+
+		return nil, ErrNoAllowedOperationStatuses
+	}
+
+	dups := duplicateOperationStatuses(statuses)
+	if len(dups) == 0 {
+		return statuses, nil
+	}
+
+	if a.lenient == nil || !a.lenient.skipDuplicateOperationStatuses {
+		return nil, fmt.Errorf("Allow.OperationStatuses contains a duplicate %s", dups[0])
+	}
+
+	for _, dup := range dups {
+		a.warnf("Allow.OperationStatuses contains a duplicate %s", dup)
+	}
+
+	return statuses, nil
+}
+
+// assertOperationTypesLenient validates Allow.OperationTypes, downgrading duplicate entries to
+// warnings as configured. It returns the OperationTypes to use on the constructed Asserter.
+func (a *Asserter) assertOperationTypesLenient(operationTypes []string) ([]string, error) {
+	if len(operationTypes) == 0 {
+		return nil, ErrNoAllowedOperationTypes
+	}
+
+	dups := duplicateOperationTypes(operationTypes)
+	if len(dups) == 0 {
+		return operationTypes, nil
+	}
+
+	if a.lenient == nil || !a.lenient.skipDuplicateOperationTypes {
+		return nil, fmt.Errorf("Allow.OperationTypes contains a duplicate %s", dups[0])
+	}
+
+	for _, dup := range dups {
+		a.warnf("Allow.OperationTypes contains a duplicate %s", dup)
+	}
+
+	return operationTypes, nil
+}
+
+// NetworkOptionsResponse ensures a *types.NetworkOptionsResponse is valid, honoring the same
+// leniency this Asserter was constructed with (downgrading empty or duplicate
+// Allow.OperationStatuses/Allow.OperationTypes to warnings instead of errors where configured).
+// It does not update the capabilities this Asserter enforces (only its Warnings); it is
+// meant for re-validating a later /network/options response against the same rules, such as
+// when polling a server for configuration drift. On an Asserter constructed without leniency
+// (NewClientWithResponses or NewClientWithFile), it behaves like the package-level
+// NetworkOptionsResponse function.
+func (a *Asserter) NetworkOptionsResponse(options *types.NetworkOptionsResponse) error {
+	if a == nil {
+		return ErrAsserterNotInitialized
+	}
+
+	if options == nil {
+		return ErrNetworkOptionsResponseIsNil
+	}
+
+	if options.Version == nil {
+		return ErrVersionIsNil
+	}
+
+	if options.Allow == nil {
+		return ErrAllowIsNil
+	}
+
+	if _, err := a.assertOperationStatusesLenient(options.Allow.OperationStatuses); err != nil {
+		return err
+	}
+
+	if _, err := a.assertOperationTypesLenient(options.Allow.OperationTypes); err != nil {
+		return err
+	}
+
+	if err := CallMethods(options.Allow.CallMethods); err != nil {
+		return err
+	}
+
+	if err := BalanceExemptions(options.Allow.BalanceExemptions); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asserter
+
+import (
+	"fmt"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+// MinUnixEpoch is the unix epoch time in milliseconds of 01/01/2000 at 12:00:00 AM. Any
+// timestamp before this value is considered invalid.
+const MinUnixEpoch = 946713600000
+
+// NetworkIdentifier ensures a *types.NetworkIdentifier has a valid Blockchain and Network.
+func NetworkIdentifier(network *types.NetworkIdentifier) error {
+	if network == nil {
+		return ErrNetworkIdentifierIsNil
+	}
+
+	if network.Blockchain == "" {
+		return ErrBlockchainEmpty
+	}
+
+	if network.Network == "" {
+		return ErrNetworkEmpty
+	}
+
+	return nil
+}
+
+// BlockIdentifier ensures a *types.BlockIdentifier is well-formatted.
+func BlockIdentifier(block *types.BlockIdentifier) error {
+	if block == nil {
+		return ErrBlockIdentifierIsNil
+	}
+
+	if block.Hash == "" {
+		return ErrBlockIdentifierHashMissing
+	}
+
+	if block.Index < 0 {
+		return ErrBlockIdentifierIndexIsNeg
+	}
+
+	return nil
+}
+
+// NetworkStatusResponse ensures a *types.NetworkStatusResponse is valid.
+func NetworkStatusResponse(response *types.NetworkStatusResponse) error {
+	if response == nil {
+		return ErrNetworkStatusResponseIsNil
+	}
+
+	if err := BlockIdentifier(response.CurrentBlockIdentifier); err != nil {
+		return err
+	}
+
+	if response.CurrentBlockTimestamp < MinUnixEpoch {
+		return ErrCurrentBlockTimestampInvalid
+	}
+
+	if err := BlockIdentifier(response.GenesisBlockIdentifier); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// duplicateOperationStatuses returns the Status of every *types.OperationStatus that appears
+// more than once in statuses, in order of appearance.
+func duplicateOperationStatuses(statuses []*types.OperationStatus) []string {
+	seen := map[string]struct{}{}
+	var duplicates []string
+	for _, status := range statuses {
+		if _, ok := seen[status.Status]; ok {
+			duplicates = append(duplicates, status.Status)
+			continue
+		}
+		seen[status.Status] = struct{}{}
+	}
+
+	return duplicates
+}
+
+// duplicateStrings returns every string that appears more than once in values, in order of
+// appearance.
+func duplicateStrings(values []string) []string {
+	seen := map[string]struct{}{}
+	var duplicates []string
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			duplicates = append(duplicates, v)
+			continue
+		}
+		seen[v] = struct{}{}
+	}
+
+	return duplicates
+}
+
+// duplicateOperationTypes returns every operation type that appears more than once in
+// operationTypes, in order of appearance.
+func duplicateOperationTypes(operationTypes []string) []string {
+	return duplicateStrings(operationTypes)
+}
+
+// OperationStatuses ensures a slice of *types.OperationStatus has no duplicate statuses and is
+// non-empty.
+func OperationStatuses(statuses []*types.OperationStatus) error {
+	if len(statuses) == 0 {
+		return ErrNoAllowedOperationStatuses
+	}
+
+	if dups := duplicateOperationStatuses(statuses); len(dups) > 0 {
+		return fmt.Errorf("Allow.OperationStatuses contains a duplicate %s", dups[0])
+	}
+
+	return nil
+}
+
+// OperationTypes ensures a slice of operation type strings has no duplicates and is non-empty.
+func OperationTypes(operationTypes []string) error {
+	if len(operationTypes) == 0 {
+		return ErrNoAllowedOperationTypes
+	}
+
+	if dups := duplicateOperationTypes(operationTypes); len(dups) > 0 {
+		return fmt.Errorf("Allow.OperationTypes contains a duplicate %s", dups[0])
+	}
+
+	return nil
+}
+
+// CallMethods ensures a slice of /call method names has no duplicates.
+func CallMethods(methods []string) error {
+	if dups := duplicateStrings(methods); len(dups) > 0 {
+		return fmt.Errorf("Allow.CallMethods contains a duplicate %s", dups[0])
+	}
+
+	return nil
+}
+
+// BalanceExemptions ensures every *types.BalanceExemption is well-formatted.
+func BalanceExemptions(exemptions []*types.BalanceExemption) error {
+	for _, exemption := range exemptions {
+		if exemption.SubAccountAddress != nil && *exemption.SubAccountAddress == "" {
+			return fmt.Errorf("BalanceExemption.SubAccountAddress is empty")
+		}
+
+		if exemption.Currency != nil && exemption.Currency.Symbol == "" {
+			return fmt.Errorf("BalanceExemption.Currency.Symbol is empty")
+		}
+
+		switch exemption.ExemptionType {
+		case "", types.BalanceExemptionTypeGreaterOrEqual, types.BalanceExemptionTypeLessOrEqual,
+			types.BalanceExemptionTypeDynamic:
+		default:
+			return fmt.Errorf("BalanceExemption.ExemptionType %s is invalid", exemption.ExemptionType)
+		}
+	}
+
+	return nil
+}
+
+// NetworkOptionsResponse ensures a *types.NetworkOptionsResponse is valid.
+func NetworkOptionsResponse(options *types.NetworkOptionsResponse) error {
+	if options == nil {
+		return ErrNetworkOptionsResponseIsNil
+	}
+
+	if options.Version == nil {
+		return ErrVersionIsNil
+	}
+
+	if options.Allow == nil {
+		return ErrAllowIsNil
+	}
+
+	if err := OperationStatuses(options.Allow.OperationStatuses); err != nil {
+		return err
+	}
+
+	if err := OperationTypes(options.Allow.OperationTypes); err != nil {
+		return err
+	}
+
+	if err := CallMethods(options.Allow.CallMethods); err != nil {
+		return err
+	}
+
+	if err := BalanceExemptions(options.Allow.BalanceExemptions); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asserter
+
+import "github.com/coinbase/rosetta-sdk-go/types"
+
+// OperationTypesSource supplies the operation types an Asserter should allow. A
+// *registry.Registry from the construction package satisfies this interface, which lets
+// Allow.OperationTypes be generated from registered construction messages instead of
+// hand-maintained alongside them.
+type OperationTypesSource interface {
+	OperationTypes() []string
+}
+
+// NewClientWithResponsesFromRegistry behaves like NewClientWithResponses but replaces
+// networkOptions.Allow.OperationTypes with source.OperationTypes() before validating, so the
+// operation types an Asserter allows can never drift from the set a construction-side
+// registry.Registry actually knows how to build.
+func NewClientWithResponsesFromRegistry(
+	network *types.NetworkIdentifier,
+	networkStatus *types.NetworkStatusResponse,
+	networkOptions *types.NetworkOptionsResponse,
+	source OperationTypesSource,
+) (*Asserter, error) {
+	if networkOptions == nil {
+		return nil, ErrNetworkOptionsResponseIsNil
+	}
+
+	if networkOptions.Allow == nil {
+		return nil, ErrAllowIsNil
+	}
+
+	allow := *networkOptions.Allow
+	allow.OperationTypes = source.OperationTypes()
+
+	options := *networkOptions
+	options.Allow = &allow
+
+	return NewClientWithResponses(network, networkStatus, &options)
+}
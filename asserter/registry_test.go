@@ -0,0 +1,75 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asserter_test
+
+import (
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/asserter"
+	"github.com/coinbase/rosetta-sdk-go/construction/registry"
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// noopMessage is a minimal registry.ConstructionMessage used only by this test.
+type noopMessage struct{}
+
+func (*noopMessage) ToOperations() []*types.Operation          { return nil }
+func (*noopMessage) FromOperations(_ []*types.Operation) error { return nil }
+func (*noopMessage) Validate() error                           { return nil }
+
+// TestNewClientWithResponsesFromRegistry demonstrates that an Asserter can be constructed
+// directly from a construction-side registry.Registry, so Allow.OperationTypes is generated
+// from registered messages instead of being hand-maintained alongside them.
+func TestNewClientWithResponsesFromRegistry(t *testing.T) {
+	r := registry.New()
+	assert.NoError(t, r.Register("Transfer", func() registry.ConstructionMessage { return &noopMessage{} }))
+	assert.NoError(t, r.Register("Delegate", func() registry.ConstructionMessage { return &noopMessage{} }))
+
+	a, err := asserter.NewClientWithResponsesFromRegistry(
+		&types.NetworkIdentifier{Blockchain: "hello", Network: "world"},
+		&types.NetworkStatusResponse{
+			GenesisBlockIdentifier: &types.BlockIdentifier{Index: 0, Hash: "block 0"},
+			CurrentBlockIdentifier: &types.BlockIdentifier{Index: 100, Hash: "block 100"},
+			CurrentBlockTimestamp:  asserter.MinUnixEpoch + 1,
+		},
+		&types.NetworkOptionsResponse{
+			Version: &types.Version{RosettaVersion: "1.2.3", NodeVersion: "1.0"},
+			Allow: &types.Allow{
+				OperationStatuses: []*types.OperationStatus{
+					{Status: "SUCCESS", Successful: true},
+				},
+				// OperationTypes is intentionally left unset here: it is expected to be
+				// overwritten with r.OperationTypes() rather than hand-maintained.
+			},
+		},
+		r,
+	)
+	assert.NoError(t, err)
+
+	assert.NoError(t, a.Operation(&types.Operation{
+		OperationIdentifier: &types.OperationIdentifier{Index: 0},
+		Type:                "Transfer",
+	}))
+	assert.NoError(t, a.Operation(&types.Operation{
+		OperationIdentifier: &types.OperationIdentifier{Index: 0},
+		Type:                "Delegate",
+	}))
+	assert.Error(t, a.Operation(&types.Operation{
+		OperationIdentifier: &types.OperationIdentifier{Index: 0},
+		Type:                "Unregistered",
+	}))
+}
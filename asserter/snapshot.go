@@ -0,0 +1,111 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asserter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+// CurrentFileConfigurationVersion is the SchemaVersion written by WriteConfigurationFile and
+// understood by NewClientWithFile without requiring a migration.
+const CurrentFileConfigurationVersion = 1
+
+// migrateFileConfiguration upgrades config in place to CurrentFileConfigurationVersion. A
+// SchemaVersion of 0 identifies a file written before SchemaVersion existed; every later
+// version's migration should be added here, guarded by its own version check, so that old files
+// keep loading correctly as the format evolves.
+func migrateFileConfiguration(config *FileConfiguration) {
+	if config.SchemaVersion == 0 {
+		config.SchemaVersion = CurrentFileConfigurationVersion
+	}
+}
+
+// Fetcher is the minimal surface a Rosetta client must implement to be snapshotted by
+// WriteConfigurationFile. Any client that can query a live server's /network/status and
+// /network/options endpoints satisfies it.
+type Fetcher interface {
+	NetworkStatus(ctx context.Context, network *types.NetworkIdentifier) (*types.NetworkStatusResponse, error)
+	NetworkOptions(ctx context.Context, network *types.NetworkIdentifier) (*types.NetworkOptionsResponse, error)
+}
+
+// WriteConfigurationFile queries fetcher's /network/status and /network/options endpoints for
+// network and writes the result to path as a canonical, JSON-encoded FileConfiguration. The
+// resulting file can be loaded with NewClientWithFile, diffed against a later snapshot with
+// DiffConfigurations, or checked into version control to detect breaking network upgrades
+// before running rosetta-cli.
+func WriteConfigurationFile(
+	ctx context.Context,
+	fetcher Fetcher,
+	network *types.NetworkIdentifier,
+	path string,
+) error {
+	status, err := fetcher.NetworkStatus(ctx, network)
+	if err != nil {
+		return fmt.Errorf("asserter: fetching network status: %w", err)
+	}
+
+	options, err := fetcher.NetworkOptions(ctx, network)
+	if err != nil {
+		return fmt.Errorf("asserter: fetching network options: %w", err)
+	}
+
+	if options.Allow == nil {
+		return ErrAllowIsNil
+	}
+
+	config := &FileConfiguration{
+		SchemaVersion:              CurrentFileConfigurationVersion,
+		NetworkIdentifier:          network,
+		GenesisBlockIdentifier:     status.GenesisBlockIdentifier,
+		AllowedOperationTypes:      options.Allow.OperationTypes,
+		AllowedOperationStatuses:   options.Allow.OperationStatuses,
+		AllowedErrors:              options.Allow.Errors,
+		AllowedTimestampStartIndex: options.Allow.TimestampStartIndex,
+		AllowedCallMethods:         options.Allow.CallMethods,
+		AllowedBalanceExemptions:   options.Allow.BalanceExemptions,
+		AllowedMempoolCoins:        options.Allow.MempoolCoins,
+	}
+
+	file, err := json.MarshalIndent(config, "", " ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, file, 0o600)
+}
+
+// ReadConfigurationFile reads and migrates the FileConfiguration stored at path without
+// constructing an Asserter from it. It is useful for loading two snapshots to compare with
+// DiffConfigurations.
+func ReadConfigurationFile(path string) (*FileConfiguration, error) {
+	file, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var config FileConfiguration
+	if err := json.Unmarshal(file, &config); err != nil {
+		return nil, err
+	}
+
+	migrateFileConfiguration(&config)
+
+	return &config, nil
+}
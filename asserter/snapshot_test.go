@@ -0,0 +1,153 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asserter
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type staticFetcher struct {
+	status  *types.NetworkStatusResponse
+	options *types.NetworkOptionsResponse
+}
+
+func (f *staticFetcher) NetworkStatus(
+	ctx context.Context,
+	network *types.NetworkIdentifier,
+) (*types.NetworkStatusResponse, error) {
+	return f.status, nil
+}
+
+func (f *staticFetcher) NetworkOptions(
+	ctx context.Context,
+	network *types.NetworkIdentifier,
+) (*types.NetworkOptionsResponse, error) {
+	return f.options, nil
+}
+
+func TestWriteAndReadConfigurationFile(t *testing.T) {
+	network := &types.NetworkIdentifier{Blockchain: "hello", Network: "world"}
+	startIndex := int64(50)
+	fetcher := &staticFetcher{
+		status: &types.NetworkStatusResponse{
+			GenesisBlockIdentifier: &types.BlockIdentifier{Index: 0, Hash: "block 0"},
+			CurrentBlockIdentifier: &types.BlockIdentifier{Index: 100, Hash: "block 100"},
+			CurrentBlockTimestamp:  MinUnixEpoch + 1,
+		},
+		options: &types.NetworkOptionsResponse{
+			Version: &types.Version{RosettaVersion: "1.2.3", NodeVersion: "1.0"},
+			Allow: &types.Allow{
+				OperationStatuses:   []*types.OperationStatus{{Status: "Success", Successful: true}},
+				OperationTypes:      []string{"Transfer"},
+				TimestampStartIndex: &startIndex,
+				CallMethods:         []string{"eth_call"},
+				MempoolCoins:        true,
+			},
+		},
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+
+	assert.NoError(t, WriteConfigurationFile(context.Background(), fetcher, network, path))
+
+	config, err := ReadConfigurationFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, CurrentFileConfigurationVersion, config.SchemaVersion)
+	assert.Equal(t, network, config.NetworkIdentifier)
+	assert.Equal(t, fetcher.status.GenesisBlockIdentifier, config.GenesisBlockIdentifier)
+	assert.Equal(t, fetcher.options.Allow.OperationTypes, config.AllowedOperationTypes)
+	assert.Equal(t, fetcher.options.Allow.TimestampStartIndex, config.AllowedTimestampStartIndex)
+	assert.Equal(t, fetcher.options.Allow.CallMethods, config.AllowedCallMethods)
+	assert.True(t, config.AllowedMempoolCoins)
+
+	asserter, err := NewClientWithFile(path)
+	assert.NoError(t, err)
+	assert.NotNil(t, asserter)
+}
+
+func TestMigrateFileConfiguration(t *testing.T) {
+	// A file written before SchemaVersion existed unmarshals with SchemaVersion 0 and should be
+	// migrated forward on read.
+	legacy := &FileConfiguration{
+		NetworkIdentifier:        &types.NetworkIdentifier{Blockchain: "hello", Network: "world"},
+		GenesisBlockIdentifier:   &types.BlockIdentifier{Index: 0, Hash: "block 0"},
+		AllowedOperationTypes:    []string{"Transfer"},
+		AllowedOperationStatuses: []*types.OperationStatus{{Status: "Success", Successful: true}},
+	}
+
+	file, err := json.Marshal(legacy)
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "legacy.json")
+	assert.NoError(t, ioutil.WriteFile(path, file, 0o600))
+
+	config, err := ReadConfigurationFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, CurrentFileConfigurationVersion, config.SchemaVersion)
+}
+
+func TestDiffConfigurations(t *testing.T) {
+	oldStartIndex := int64(10)
+	newStartIndex := int64(20)
+
+	old := &FileConfiguration{
+		AllowedOperationTypes: []string{"Transfer", "Reward"},
+		AllowedOperationStatuses: []*types.OperationStatus{
+			{Status: "Success", Successful: true},
+		},
+		AllowedErrors: []*types.Error{
+			{Code: 1, Message: "not found"},
+		},
+		AllowedTimestampStartIndex: &oldStartIndex,
+		AllowedCallMethods:         []string{"eth_call"},
+		AllowedMempoolCoins:        false,
+	}
+
+	updated := &FileConfiguration{
+		AllowedOperationTypes: []string{"Transfer", "Fee"},
+		AllowedOperationStatuses: []*types.OperationStatus{
+			{Status: "Success", Successful: true},
+			{Status: "Failure", Successful: false},
+		},
+		AllowedErrors: []*types.Error{
+			{Code: 2, Message: "invalid request"},
+		},
+		AllowedTimestampStartIndex: &newStartIndex,
+		AllowedCallMethods:         []string{"eth_call", "eth_getBalance"},
+		AllowedMempoolCoins:        true,
+	}
+
+	diff := DiffConfigurations(old, updated)
+	assert.False(t, diff.IsEmpty())
+	assert.Equal(t, []string{"Fee"}, diff.AddedOperationTypes)
+	assert.Equal(t, []string{"Reward"}, diff.RemovedOperationTypes)
+	assert.Equal(t, []*types.OperationStatus{{Status: "Failure", Successful: false}}, diff.AddedOperationStatuses)
+	assert.Empty(t, diff.RemovedOperationStatuses)
+	assert.Equal(t, []*types.Error{{Code: 2, Message: "invalid request"}}, diff.AddedErrors)
+	assert.Equal(t, []*types.Error{{Code: 1, Message: "not found"}}, diff.RemovedErrors)
+	assert.Len(t, diff.AllowFieldChanges, 3)
+
+	assert.True(t, DiffConfigurations(old, old).IsEmpty())
+}
@@ -0,0 +1,33 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balancetracker
+
+import "github.com/coinbase/rosetta-sdk-go/types"
+
+// Discrepancy describes a divergence between the balance a Tracker computed by replaying
+// blocks and the balance reported by a live /account/balance response for the same account,
+// currency, and block.
+type Discrepancy struct {
+	Account  *types.AccountIdentifier `json:"account"`
+	Currency *types.Currency          `json:"currency"`
+	Index    int64                    `json:"index"`
+
+	// TrackedValue is the balance the Tracker computed by replaying blocks, in atomic units.
+	TrackedValue string `json:"tracked_value"`
+
+	// ReportedValue is the balance returned by the live /account/balance response, in atomic
+	// units.
+	ReportedValue string `json:"reported_value"`
+}
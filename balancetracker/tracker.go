@@ -0,0 +1,216 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package balancetracker consumes a stream of blocks and maintains a running, historically
+// queryable balance for every AccountIdentifier and Currency it observes, so that callers can
+// reconcile their own bookkeeping against a Rosetta server's /account/balance endpoint without
+// re-deriving balances from scratch on every request.
+package balancetracker
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+type key struct {
+	account  string
+	currency string
+}
+
+func newKey(account *types.AccountIdentifier, currency *types.Currency) key {
+	k := key{currency: currency.Symbol}
+
+	if account != nil {
+		k.account = account.Address
+		if account.SubAccountIdentifier != nil {
+			k.account += "/" + account.SubAccountIdentifier.Address
+		}
+	}
+
+	return k
+}
+
+type balanceAtIndex struct {
+	index int64
+	value *big.Int
+}
+
+// Option configures a Tracker at construction time.
+type Option func(*Tracker)
+
+// WithDiscrepancyHandler registers a function that is invoked every time Reconcile finds a
+// Discrepancy between the tracked balance and a reported one.
+func WithDiscrepancyHandler(handler func(*Discrepancy)) Option {
+	return func(t *Tracker) {
+		t.onDiscrepancy = handler
+	}
+}
+
+// Tracker maintains a running balance, indexed by BlockIdentifier.Index, for every
+// AccountIdentifier and Currency it observes in blocks passed to ProcessBlock.
+type Tracker struct {
+	mu            sync.Mutex
+	history       map[key][]balanceAtIndex
+	onDiscrepancy func(*Discrepancy)
+}
+
+// New constructs an empty Tracker.
+func New(opts ...Option) *Tracker {
+	t := &Tracker{
+		history: map[key][]balanceAtIndex{},
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	return t
+}
+
+// ProcessBlock applies every Operation with a non-nil Account and Amount in block to the
+// running balance of the corresponding account and currency.
+func (t *Tracker) ProcessBlock(block *types.Block) error {
+	if block == nil || block.BlockIdentifier == nil {
+		return fmt.Errorf("balancetracker: block or BlockIdentifier is nil")
+	}
+
+	for _, transaction := range block.Transactions {
+		for _, operation := range transaction.Operations {
+			if operation.Account == nil || operation.Amount == nil {
+				continue
+			}
+
+			if err := t.apply(block.BlockIdentifier.Index, operation.Account, operation.Amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (t *Tracker) apply(index int64, account *types.AccountIdentifier, amount *types.Amount) error {
+	if amount.Currency == nil {
+		return fmt.Errorf("balancetracker: amount has no currency")
+	}
+
+	delta, ok := new(big.Int).SetString(amount.Value, 10)
+	if !ok {
+		return fmt.Errorf("balancetracker: invalid amount value %q", amount.Value)
+	}
+
+	k := newKey(account, amount.Currency)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	next := new(big.Int).Add(t.latestLocked(k), delta)
+	t.history[k] = append(t.history[k], balanceAtIndex{index: index, value: next})
+
+	return nil
+}
+
+// latestLocked returns the most recently recorded balance for k. It must be called with t.mu
+// held.
+func (t *Tracker) latestLocked(k key) *big.Int {
+	records := t.history[k]
+	if len(records) == 0 {
+		return big.NewInt(0)
+	}
+
+	return records[len(records)-1].value
+}
+
+// Balance returns the tracked balance of account in currency as of the most recent block with
+// an index <= index. The second return value is false if no balance has been recorded for this
+// account and currency at or before index.
+func (t *Tracker) Balance(
+	account *types.AccountIdentifier,
+	currency *types.Currency,
+	index int64,
+) (*big.Int, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	records := t.history[newKey(account, currency)]
+
+	var result *big.Int
+	for _, record := range records {
+		if record.index > index {
+			break
+		}
+		result = record.value
+	}
+
+	return result, result != nil
+}
+
+// Reconcile compares the tracked balance of account in currency against a live
+// *types.AccountBalanceResponse. It returns a non-nil *Discrepancy (and invokes any registered
+// discrepancy handler) if the two diverge.
+func (t *Tracker) Reconcile(
+	account *types.AccountIdentifier,
+	currency *types.Currency,
+	response *types.AccountBalanceResponse,
+) (*Discrepancy, error) {
+	if response == nil || response.BlockIdentifier == nil {
+		return nil, fmt.Errorf("balancetracker: response or BlockIdentifier is nil")
+	}
+
+	reported, err := amountForCurrency(response.Balances, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	reportedValue, ok := new(big.Int).SetString(reported.Value, 10)
+	if !ok {
+		return nil, fmt.Errorf("balancetracker: invalid amount value %q", reported.Value)
+	}
+
+	tracked, found := t.Balance(account, currency, response.BlockIdentifier.Index)
+	if !found {
+		tracked = big.NewInt(0)
+	}
+
+	if tracked.Cmp(reportedValue) == 0 {
+		return nil, nil
+	}
+
+	discrepancy := &Discrepancy{
+		Account:       account,
+		Currency:      currency,
+		Index:         response.BlockIdentifier.Index,
+		TrackedValue:  tracked.String(),
+		ReportedValue: reportedValue.String(),
+	}
+
+	if t.onDiscrepancy != nil {
+		t.onDiscrepancy(discrepancy)
+	}
+
+	return discrepancy, nil
+}
+
+func amountForCurrency(balances []*types.Amount, currency *types.Currency) (*types.Amount, error) {
+	for _, balance := range balances {
+		if balance.Currency != nil && balance.Currency.Symbol == currency.Symbol {
+			return balance, nil
+		}
+	}
+
+	return nil, fmt.Errorf("balancetracker: no balance found for currency %s", currency.Symbol)
+}
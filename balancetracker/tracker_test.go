@@ -0,0 +1,93 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balancetracker
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	account  = &types.AccountIdentifier{Address: "addr1"}
+	currency = &types.Currency{Symbol: "BTC", Decimals: 8}
+)
+
+func block(index int64, value string) *types.Block {
+	return &types.Block{
+		BlockIdentifier: &types.BlockIdentifier{Index: index, Hash: "hash"},
+		Transactions: []*types.Transaction{
+			{
+				TransactionIdentifier: &types.TransactionIdentifier{Hash: "tx"},
+				Operations: []*types.Operation{
+					{
+						OperationIdentifier: &types.OperationIdentifier{Index: 0},
+						Type:                "Transfer",
+						Account:             account,
+						Amount:              &types.Amount{Value: value, Currency: currency},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestProcessBlockAndBalance(t *testing.T) {
+	tracker := New()
+
+	assert.NoError(t, tracker.ProcessBlock(block(1, "100")))
+	assert.NoError(t, tracker.ProcessBlock(block(2, "-40")))
+
+	balance, found := tracker.Balance(account, currency, 1)
+	assert.True(t, found)
+	assert.Equal(t, big.NewInt(100), balance)
+
+	balance, found = tracker.Balance(account, currency, 2)
+	assert.True(t, found)
+	assert.Equal(t, big.NewInt(60), balance)
+
+	_, found = tracker.Balance(account, currency, 0)
+	assert.False(t, found)
+}
+
+func TestReconcile(t *testing.T) {
+	var discrepancies []*Discrepancy
+	tracker := New(WithDiscrepancyHandler(func(d *Discrepancy) {
+		discrepancies = append(discrepancies, d)
+	}))
+
+	assert.NoError(t, tracker.ProcessBlock(block(1, "100")))
+
+	matching, err := tracker.Reconcile(account, currency, &types.AccountBalanceResponse{
+		BlockIdentifier: &types.BlockIdentifier{Index: 1, Hash: "hash"},
+		Balances:        []*types.Amount{{Value: "100", Currency: currency}},
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, matching)
+	assert.Empty(t, discrepancies)
+
+	mismatch, err := tracker.Reconcile(account, currency, &types.AccountBalanceResponse{
+		BlockIdentifier: &types.BlockIdentifier{Index: 1, Hash: "hash"},
+		Balances:        []*types.Amount{{Value: "101", Currency: currency}},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, mismatch)
+	assert.Equal(t, "100", mismatch.TrackedValue)
+	assert.Equal(t, "101", mismatch.ReportedValue)
+	assert.Len(t, discrepancies, 1)
+}
@@ -0,0 +1,209 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package registry lets an implementer register application-defined message types that know
+// how to convert themselves to and from Rosetta Operations. Once registered, the SDK can use
+// those messages to implement the Construction API's /construction/preprocess,
+// /construction/payloads, and /construction/parse endpoints without any chain-specific code in
+// the SDK itself.
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+// ConstructionMessage is implemented by an application-defined message that represents a single
+// Rosetta operation type (ex: a "Transfer" or a "Delegate").
+type ConstructionMessage interface {
+	// ToOperations returns the Rosetta Operations that represent this message.
+	ToOperations() []*types.Operation
+
+	// FromOperations populates the message from a set of Rosetta Operations of the type this
+	// message was registered for.
+	FromOperations(operations []*types.Operation) error
+
+	// Validate returns an error if the message is not well-formed.
+	Validate() error
+}
+
+// Factory constructs a new, zero-value ConstructionMessage.
+type Factory func() ConstructionMessage
+
+// Registry maps Rosetta operation types to the ConstructionMessage that implements them.
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}
+
+// New constructs an empty Registry.
+func New() *Registry {
+	return &Registry{
+		factories: map[string]Factory{},
+	}
+}
+
+// Register associates operationType with factory. It returns an error if operationType is
+// empty or already registered.
+func (r *Registry) Register(operationType string, factory Factory) error {
+	if operationType == "" {
+		return fmt.Errorf("registry: operation type is empty")
+	}
+
+	if factory == nil {
+		return fmt.Errorf("registry: factory for operation type %s is nil", operationType)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.factories[operationType]; ok {
+		return fmt.Errorf("registry: operation type %s is already registered", operationType)
+	}
+
+	r.factories[operationType] = factory
+
+	return nil
+}
+
+// OperationTypes returns every operation type with a registered message, sorted lexically. It
+// is meant to feed a Rosetta server's types.Allow.OperationTypes so that the advertised set of
+// operation types never drifts from the set the Construction API actually supports.
+func (r *Registry) OperationTypes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	operationTypes := make([]string, 0, len(r.factories))
+	for operationType := range r.factories {
+		operationTypes = append(operationTypes, operationType)
+	}
+	sort.Strings(operationTypes)
+
+	return operationTypes
+}
+
+// NewMessage constructs a new ConstructionMessage for operationType using its registered
+// Factory.
+func (r *Registry) NewMessage(operationType string) (ConstructionMessage, error) {
+	r.mu.RLock()
+	factory, ok := r.factories[operationType]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("registry: no message registered for operation type %s", operationType)
+	}
+
+	return factory(), nil
+}
+
+// groupByType partitions operations by Type and reconstructs the registered ConstructionMessage
+// for each group.
+func (r *Registry) groupByType(operations []*types.Operation) (map[string]ConstructionMessage, error) {
+	grouped := map[string][]*types.Operation{}
+	for _, operation := range operations {
+		grouped[operation.Type] = append(grouped[operation.Type], operation)
+	}
+
+	messages := make(map[string]ConstructionMessage, len(grouped))
+	for operationType, ops := range grouped {
+		message, err := r.NewMessage(operationType)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := message.FromOperations(ops); err != nil {
+			return nil, fmt.Errorf("registry: %s message: %w", operationType, err)
+		}
+
+		messages[operationType] = message
+	}
+
+	return messages, nil
+}
+
+// Preprocess implements the core of a chain-agnostic /construction/preprocess handler: it
+// reconstructs the registered message for every operation type present in operations, validates
+// each one, and returns them keyed by operation type so a caller can use them to determine what
+// on-chain metadata (ex: an account's sequence number) /construction/metadata should fetch.
+func (r *Registry) Preprocess(operations []*types.Operation) (map[string]ConstructionMessage, error) {
+	messages, err := r.groupByType(operations)
+	if err != nil {
+		return nil, err
+	}
+
+	for operationType, message := range messages {
+		if err := message.Validate(); err != nil {
+			return nil, fmt.Errorf("registry: %s message is invalid: %w", operationType, err)
+		}
+	}
+
+	return messages, nil
+}
+
+// Payloads implements the core of a chain-agnostic /construction/payloads handler: it
+// reconstructs the registered message for every operation type present in operations and
+// returns the canonical Operations produced by each message's ToOperations, ready for a
+// chain-specific signer to turn into signing payloads. The operation types in the result are
+// ordered by their first appearance in operations, since operation order (and the
+// OperationIdentifier.Index values inside it) is significant to the signer that consumes it.
+func (r *Registry) Payloads(operations []*types.Operation) ([]*types.Operation, error) {
+	messages, err := r.groupByType(operations)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*types.Operation, 0, len(operations))
+	for _, operationType := range firstSeenKeys(operations) {
+		result = append(result, messages[operationType].ToOperations()...)
+	}
+
+	return result, nil
+}
+
+// Parse implements the core of a chain-agnostic /construction/parse handler: it reconstructs
+// the registered message for every operation type present in operations, keyed by operation
+// type.
+func (r *Registry) Parse(operations []*types.Operation) (map[string]ConstructionMessage, error) {
+	return r.groupByType(operations)
+}
+
+// Allow returns a copy of base with OperationTypes replaced by r.OperationTypes(). Pass the
+// result as the Allow field of the types.NetworkOptionsResponse given to
+// asserter.NewClientWithResponses so the advertised operation types always match what the
+// registry can actually construct.
+func (r *Registry) Allow(base *types.Allow) *types.Allow {
+	allow := *base
+	allow.OperationTypes = r.OperationTypes()
+
+	return &allow
+}
+
+// firstSeenKeys returns the distinct Type of every operation in operations, in the order each
+// Type first appears.
+func firstSeenKeys(operations []*types.Operation) []string {
+	seen := map[string]struct{}{}
+	var keys []string
+	for _, operation := range operations {
+		if _, ok := seen[operation.Type]; ok {
+			continue
+		}
+		seen[operation.Type] = struct{}{}
+		keys = append(keys, operation.Type)
+	}
+
+	return keys
+}
@@ -0,0 +1,148 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// transferMessage is a minimal ConstructionMessage used only by this test.
+type transferMessage struct {
+	From, To string
+	Value    string
+}
+
+func (m *transferMessage) ToOperations() []*types.Operation {
+	return []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			Type:                "Transfer",
+			Account:             &types.AccountIdentifier{Address: m.From},
+			Amount:              &types.Amount{Value: "-" + m.Value},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 1},
+			Type:                "Transfer",
+			Account:             &types.AccountIdentifier{Address: m.To},
+			Amount:              &types.Amount{Value: m.Value},
+		},
+	}
+}
+
+func (m *transferMessage) FromOperations(operations []*types.Operation) error {
+	for _, op := range operations {
+		if op.Amount.Value[0] == '-' {
+			m.From = op.Account.Address
+			m.Value = op.Amount.Value[1:]
+		} else {
+			m.To = op.Account.Address
+		}
+	}
+
+	return nil
+}
+
+func (m *transferMessage) Validate() error {
+	if m.From == "" || m.To == "" {
+		return assert.AnError
+	}
+
+	return nil
+}
+
+func TestRegistry(t *testing.T) {
+	r := New()
+	assert.NoError(t, r.Register("Transfer", func() ConstructionMessage { return &transferMessage{} }))
+	assert.Error(t, r.Register("Transfer", func() ConstructionMessage { return &transferMessage{} }))
+
+	assert.Equal(t, []string{"Transfer"}, r.OperationTypes())
+
+	msg := &transferMessage{From: "a", To: "b", Value: "10"}
+	operations := msg.ToOperations()
+
+	messages, err := r.Preprocess(operations)
+	assert.NoError(t, err)
+	assert.Equal(t, msg, messages["Transfer"])
+
+	payloadOps, err := r.Payloads(operations)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, operations, payloadOps)
+
+	parsed, err := r.Parse(operations)
+	assert.NoError(t, err)
+	assert.Equal(t, msg, parsed["Transfer"])
+
+	allow := r.Allow(&types.Allow{HistoricalBalanceLookup: true})
+	assert.Equal(t, []string{"Transfer"}, allow.OperationTypes)
+	assert.True(t, allow.HistoricalBalanceLookup)
+}
+
+// feeMessage is a minimal ConstructionMessage used only by this test.
+type feeMessage struct {
+	Payer string
+	Value string
+}
+
+func (m *feeMessage) ToOperations() []*types.Operation {
+	return []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			Type:                "Fee",
+			Account:             &types.AccountIdentifier{Address: m.Payer},
+			Amount:              &types.Amount{Value: "-" + m.Value},
+		},
+	}
+}
+
+func (m *feeMessage) FromOperations(operations []*types.Operation) error {
+	m.Payer = operations[0].Account.Address
+	m.Value = operations[0].Amount.Value[1:]
+
+	return nil
+}
+
+func (m *feeMessage) Validate() error {
+	if m.Payer == "" {
+		return assert.AnError
+	}
+
+	return nil
+}
+
+// TestRegistryPayloadsPreservesOrder guards against Payloads silently reordering operations of
+// different types: the chain-specific signer that consumes its result relies on operation order
+// (and the OperationIdentifier.Index values inside it) being preserved.
+func TestRegistryPayloadsPreservesOrder(t *testing.T) {
+	r := New()
+	assert.NoError(t, r.Register("Transfer", func() ConstructionMessage { return &transferMessage{} }))
+	assert.NoError(t, r.Register("Fee", func() ConstructionMessage { return &feeMessage{} }))
+
+	transfer := &transferMessage{From: "a", To: "b", Value: "10"}
+	fee := &feeMessage{Payer: "a", Value: "1"}
+
+	operations := append(transfer.ToOperations(), fee.ToOperations()...)
+	payloadOps, err := r.Payloads(operations)
+	assert.NoError(t, err)
+	assert.Equal(t, operations, payloadOps)
+
+	reordered := append(fee.ToOperations(), transfer.ToOperations()...)
+	payloadOps, err = r.Payloads(reordered)
+	assert.NoError(t, err)
+	assert.Equal(t, reordered, payloadOps)
+}
@@ -0,0 +1,26 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated by: OpenAPI Generator (https://openapi-generator.tech)
+
+package types
+
+// AccountBalanceResponse is returned on the /account/balance endpoint. If an account has a
+// balance for each AccountIdentifier describing it (ex: an ERC-20 token balance on a HD
+// account), an account balance request must be made with each AccountIdentifier.
+type AccountBalanceResponse struct {
+	BlockIdentifier *BlockIdentifier       `json:"block_identifier"`
+	Balances        []*Amount              `json:"balances"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+}
@@ -0,0 +1,35 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated by: OpenAPI Generator (https://openapi-generator.tech)
+
+package types
+
+// AccountIdentifier uniquely identifies an account within a network. All fields in the
+// AccountIdentifier are utilized to determine this uniqueness (including the metadata field, if
+// populated).
+type AccountIdentifier struct {
+	// The address may be a cryptographic public key (or some encoding of it) or a provided
+	// username.
+	Address              string                 `json:"address"`
+	SubAccountIdentifier *SubAccountIdentifier  `json:"sub_account,omitempty"`
+	Metadata             map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// SubAccountIdentifier is used to specify a sub-account. Examples of sub-accounts include staked
+// funds, locked funds, or voting funds.
+type SubAccountIdentifier struct {
+	Address  string                 `json:"address"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
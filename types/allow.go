@@ -33,4 +33,21 @@ type Allow struct {
 	// Any Rosetta implementation that supports querying the balance of an account at any height in
 	// the past should set this to true.
 	HistoricalBalanceLookup bool `json:"historical_balance_lookup"`
+	// Timestamps of blocks with an index below this one in the node's canonical chain are not
+	// guaranteed to be valid and clients should not assert on them. Timestamps of blocks at this
+	// index and above are asserted.
+	TimestampStartIndex *int64 `json:"timestamp_start_index,omitempty"`
+	// All methods that are supported by the /call endpoint. Communicating which parameters
+	// should be provided to /call is the responsibility of the implementer (no interface is
+	// provided to do this).
+	CallMethods []string `json:"call_methods"`
+	// BalanceExemptions is an array of BalanceExemption indicating which account balances could
+	// change without a corresponding Operation. BalanceExemptions should be used sparingly as
+	// they may introduce significant complexity for integrators that attempt to reconcile
+	// account balance changes.
+	BalanceExemptions []*BalanceExemption `json:"balance_exemptions,omitempty"`
+	// Any Rosetta implementation that can update an account's unspent coins based on the
+	// contents of the mempool should set this to true. This is relevant for integrators that
+	// return coin-based balances.
+	MempoolCoins bool `json:"mempool_coins"`
 }
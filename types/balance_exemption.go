@@ -0,0 +1,45 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated by: OpenAPI Generator (https://openapi-generator.tech)
+
+package types
+
+// BalanceExemptionType indicates the kind of exemption an account balance is granted from strict
+// reconciliation, as described by BalanceExemption.ExemptionType.
+type BalanceExemptionType string
+
+const (
+	// BalanceExemptionTypeGreaterOrEqual means the live balance may be greater than or equal to
+	// the computed balance.
+	BalanceExemptionTypeGreaterOrEqual BalanceExemptionType = "greater_or_equal"
+
+	// BalanceExemptionTypeLessOrEqual means the live balance may be less than or equal to the
+	// computed balance.
+	BalanceExemptionTypeLessOrEqual BalanceExemptionType = "less_or_equal"
+
+	// BalanceExemptionTypeDynamic means the live balance may differ from the computed balance
+	// in either direction.
+	BalanceExemptionTypeDynamic BalanceExemptionType = "dynamic"
+)
+
+// BalanceExemption indicates that the live balance for an account may diverge from the
+// computed balance for a particular SubAccountIdentifier and/or Currency without being
+// considered an error. It is most commonly used to exempt balances that change as a result of
+// non-transactional rewards (ex: staking rewards that accrue each block).
+type BalanceExemption struct {
+	SubAccountAddress *string              `json:"sub_account_address,omitempty"`
+	Currency          *Currency            `json:"currency,omitempty"`
+	ExemptionType     BalanceExemptionType `json:"exemption_type,omitempty"`
+}
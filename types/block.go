@@ -0,0 +1,30 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated by: OpenAPI Generator (https://openapi-generator.tech)
+
+package types
+
+// Block contains an array of Transactions that occurred at a particular BlockIdentifier. A hard
+// requirement for blocks returned by Rosetta implementations is that they MUST be
+// _inalterable_: once a client has requested and received a block identified by a specific
+// BlockIdentifier, all future calls for that same BlockIdentifier must return the same block
+// contents.
+type Block struct {
+	BlockIdentifier       *BlockIdentifier       `json:"block_identifier"`
+	ParentBlockIdentifier *BlockIdentifier       `json:"parent_block_identifier"`
+	Timestamp             int64                  `json:"timestamp"`
+	Transactions          []*Transaction         `json:"transactions"`
+	Metadata              map[string]interface{} `json:"metadata,omitempty"`
+}
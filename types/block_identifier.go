@@ -0,0 +1,33 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated by: OpenAPI Generator (https://openapi-generator.tech)
+
+package types
+
+// BlockIdentifier uniquely identifies a block in a particular network.
+type BlockIdentifier struct {
+	// This is also known as the block height.
+	Index int64 `json:"index"`
+	// This should be normalized according to the correct algorithm for the chain-id. This
+	// usually means hex-encoding and then adding a leading `0x`.
+	Hash string `json:"hash"`
+}
+
+// PartialBlockIdentifier allows clients to fetch information about a block without specifying
+// the block's unique identifier.
+type PartialBlockIdentifier struct {
+	Index *int64  `json:"index,omitempty"`
+	Hash  *string `json:"hash,omitempty"`
+}
@@ -0,0 +1,38 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated by: OpenAPI Generator (https://openapi-generator.tech)
+
+package types
+
+// CallRequest is the input to the /call endpoint. It invokes an arbitrary, network-specific
+// method with the specified Parameters.
+type CallRequest struct {
+	NetworkIdentifier *NetworkIdentifier `json:"network_identifier"`
+	// Method is some network-specific procedure name. It must match one of the methods
+	// advertised in Allow.CallMethods.
+	Method     string                 `json:"method"`
+	Parameters map[string]interface{} `json:"parameters"`
+}
+
+// CallResponse contains the result of a /call invocation. This result is a JSON-like object
+// whose contents are entirely network-specific.
+type CallResponse struct {
+	// Result of a /call method call.
+	Result map[string]interface{} `json:"result"`
+	// Idempotent indicates that if /call is called with the same CallRequest again, the
+	// same CallResponse will be returned. Implementations that don't want to make this
+	// guarantee should leave this as false.
+	Idempotent bool `json:"idempotent"`
+}
@@ -0,0 +1,41 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated by: OpenAPI Generator (https://openapi-generator.tech)
+
+package types
+
+// CoinAction are types of a CoinChange.
+type CoinAction string
+
+// CoinCreated and CoinSpent are the only permitted CoinAction values.
+const (
+	CoinCreated CoinAction = "coin_created"
+	CoinSpent   CoinAction = "coin_spent"
+)
+
+// CoinIdentifier uniquely identifies a Coin.
+type CoinIdentifier struct {
+	// Identifier should be populated with a globally unique identifier of a Coin. In Bitcoin, this
+	// identifier would be transaction_hash:index.
+	Identifier string `json:"identifier"`
+}
+
+// CoinChange is used to represent a change in state of a some coin identified by a
+// CoinIdentifier. This object is part of the Operation model and must be populated for
+// UTXO-based blockchains.
+type CoinChange struct {
+	CoinIdentifier *CoinIdentifier `json:"coin_identifier"`
+	CoinAction     CoinAction      `json:"coin_action"`
+}
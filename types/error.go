@@ -0,0 +1,29 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated by: OpenAPI Generator (https://openapi-generator.tech)
+
+package types
+
+// Error is used by implementations to return informative error responses. Error traits are
+// intended to be generic and should be the same across all implementations.
+type Error struct {
+	// Code is a network-specific error code. This code should be unique across errors.
+	Code int32 `json:"code"`
+	// Message is a network-specific error message.
+	Message string `json:"message"`
+	// An error is retriable if the same request may succeed if submitted again.
+	Retriable bool                   `json:"retriable"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
@@ -0,0 +1,38 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated by: OpenAPI Generator (https://openapi-generator.tech)
+
+package types
+
+// NetworkStatusResponse contains basic information about the node's view of a blockchain network.
+type NetworkStatusResponse struct {
+	CurrentBlockIdentifier *BlockIdentifier `json:"current_block_identifier"`
+	// The timestamp of the block in milliseconds since the Unix Epoch. The timestamp is stored in
+	// milliseconds because some blockchains produce blocks more than once a second.
+	CurrentBlockTimestamp  int64            `json:"current_block_timestamp"`
+	GenesisBlockIdentifier *BlockIdentifier `json:"genesis_block_identifier"`
+	OldestBlockIdentifier  *BlockIdentifier `json:"oldest_block_identifier,omitempty"`
+	SyncStatus             *SyncStatus      `json:"sync_status,omitempty"`
+	Peers                  []*Peer          `json:"peers"`
+}
+
+// SyncStatus is used to indicate if the server is healthy, in sync with an external source, or
+// is in an error state.
+type SyncStatus struct {
+	CurrentIndex *int64  `json:"current_index,omitempty"`
+	TargetIndex  *int64  `json:"target_index,omitempty"`
+	Stage        *string `json:"stage,omitempty"`
+	Synced       *bool   `json:"synced,omitempty"`
+}
@@ -0,0 +1,48 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated by: OpenAPI Generator (https://openapi-generator.tech)
+
+package types
+
+// OperationIdentifier uniquely identifies an operation within a transaction.
+type OperationIdentifier struct {
+	// The operation index is used to ensure each operation has a unique identifier within a
+	// transaction. This index is only relevant in the context of this transaction.
+	Index int64 `json:"index"`
+	// Some blockchains specify an operation index that is essential for client use. For example,
+	// Bitcoin uses a network index that is identical to the operation index.
+	NetworkIndex *int64 `json:"network_index,omitempty"`
+}
+
+// Operation contains all balance-changing information within a transaction. It is always
+// assumed that Operations are inclusive of all balance changes (unless explicitly marked as not
+// related to an Amount).
+type Operation struct {
+	OperationIdentifier *OperationIdentifier   `json:"operation_identifier"`
+	RelatedOperations   []*OperationIdentifier `json:"related_operations,omitempty"`
+	// Type is the network-specific type of the operation. Ensure that any type that can be
+	// returned here is also specified in the NetworkOptionsResponse. This can be very useful to
+	// downstream consumers that parse all block data.
+	Type string `json:"type"`
+	// Status is the network-specific status of the operation. Status is not defined on the
+	// transaction object because blockchains with smart contracts may have transaction-wide
+	// statuses and may require multiple operation statuses to determine the status of an
+	// individual operation.
+	Status     *string                `json:"status,omitempty"`
+	Account    *AccountIdentifier     `json:"account,omitempty"`
+	Amount     *Amount                `json:"amount,omitempty"`
+	CoinChange *CoinChange            `json:"coin_change,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+}
@@ -0,0 +1,27 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated by: OpenAPI Generator (https://openapi-generator.tech)
+
+package types
+
+// OperationStatus is utilized to indicate which Operation status are considered successful.
+type OperationStatus struct {
+	// The status is the network-specific status of the operation.
+	Status string `json:"status"`
+	// An Operation is considered successful if the Operation.Amount is correctly applied from
+	// the Operation.Account. Operations are not considered successful if the network considers
+	// the operation inconclusive or reverted.
+	Successful bool `json:"successful"`
+}
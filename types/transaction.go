@@ -0,0 +1,33 @@
+// Copyright 2020 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated by: OpenAPI Generator (https://openapi-generator.tech)
+
+package types
+
+// TransactionIdentifier uniquely identifies a transaction in a particular network and block or
+// in the mempool.
+type TransactionIdentifier struct {
+	// Any transactions that are attributable only to a block (ex: a block event) should use the
+	// hash of the block as the identifier.
+	Hash string `json:"hash"`
+}
+
+// Transaction contains an array of Operations that are attributable to the same
+// TransactionIdentifier.
+type Transaction struct {
+	TransactionIdentifier *TransactionIdentifier `json:"transaction_identifier"`
+	Operations            []*Operation           `json:"operations"`
+	Metadata              map[string]interface{} `json:"metadata,omitempty"`
+}